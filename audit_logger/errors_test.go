@@ -0,0 +1,53 @@
+package auditlogger
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLoggerWithConfigForwardsLogValuesFuncError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	sinkErr := errors.New("sink unavailable")
+
+	var finalErrors []*gin.Error
+	router := gin.New()
+	// Registered before LoggerWithConfig, so its post-c.Next() code runs
+	// after LoggerWithConfig (and its c.Error call) has fully unwound.
+	router.Use(func(c *gin.Context) {
+		c.Next()
+		finalErrors = c.Errors
+	})
+	router.Use(LoggerWithConfig(LoggerConfig{
+		LogValuesFunc: func(c *gin.Context, v RequestLoggerParams) error {
+			return sinkErr
+		},
+	}))
+	router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(finalErrors) != 1 || !errors.Is(finalErrors[0].Err, sinkErr) {
+		t.Errorf("expected c.Errors to contain the LogValuesFunc error, got %v", finalErrors)
+	}
+}
+
+func TestWrapLogValuesFunc(t *testing.T) {
+	var gotParams RequestLoggerParams
+
+	legacy := func(c *gin.Context, v RequestLoggerParams) {
+		gotParams = v
+	}
+
+	err := WrapLogValuesFunc(legacy)(nil, RequestLoggerParams{Status: http.StatusTeapot})
+	if err != nil {
+		t.Errorf("WrapLogValuesFunc shim should never return an error, got %v", err)
+	}
+	if gotParams.Status != http.StatusTeapot {
+		t.Errorf("expected wrapped function to be called with params, got %+v", gotParams)
+	}
+}
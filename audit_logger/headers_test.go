@@ -0,0 +1,49 @@
+package auditlogger
+
+import "testing"
+
+func TestFlatHeaders(t *testing.T) {
+	params := RequestLoggerParams{
+		Headers: map[string][]string{
+			"X-Request-ID": {"abc-123"},
+		},
+		ResponseHeaders: map[string][]string{
+			"Cache-Control": {"no-cache", "no-store"},
+		},
+	}
+
+	flat := params.FlatHeaders()
+	if got, want := flat["req_X-Request-ID"], "abc-123"; got != want {
+		t.Errorf("req_X-Request-ID = %q, want %q", got, want)
+	}
+	if got, want := flat["resp_Cache-Control"], "no-cache, no-store"; got != want {
+		t.Errorf("resp_Cache-Control = %q, want %q", got, want)
+	}
+}
+
+func TestFlatHeadersNormalized(t *testing.T) {
+	params := RequestLoggerParams{
+		normalizeHeaderKeys: true,
+		Headers: map[string][]string{
+			"X-Request-ID": {"abc-123"},
+		},
+		ResponseHeaders: map[string][]string{
+			"Cache-Control": {"no-cache"},
+			"X-Request-Id":  {"abc-123"},
+			"Content-Type":  {"application/json"},
+		},
+	}
+
+	flat := params.FlatHeaders()
+	want := map[string]string{
+		"req_x_request_id":   "abc-123",
+		"resp_cache_control": "no-cache",
+		"resp_x_request_id":  "abc-123",
+		"resp_content_type":  "application/json",
+	}
+	for key, val := range want {
+		if got := flat[key]; got != val {
+			t.Errorf("flat[%q] = %q, want %q", key, got, val)
+		}
+	}
+}
@@ -1,12 +1,21 @@
 package auditlogger
 
 import (
-	"github.com/gin-gonic/gin"
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
 	"time"
+
+	"github.com/gin-gonic/gin"
 )
 
 type Skipper func(c *gin.Context) bool
 
+// RedactBodyFunc lets callers strip or mask sensitive data from a captured
+// body before it reaches LogValuesFunc.
+type RedactBodyFunc func(contentType string, body []byte) []byte
+
 type LoggerConfig struct {
 	// Skipper defines a function to skip middleware
 	Skipper Skipper
@@ -14,8 +23,33 @@ type LoggerConfig struct {
 	// SkipPaths is an url path array which logs are not written.
 	SkipPaths []string
 
+	// SkipPathRegexps is matched against c.Request.URL.Path after c.Next()
+	// runs; a match skips the request the same way SkipPaths does.
+	SkipPathRegexps []*regexp.Regexp
+
 	// LogValuesFunc defines a function that is called with values extracted by logger.
-	LogValuesFunc func(c *gin.Context, v RequestLoggerParams)
+	// Any error it returns is forwarded to gin's error chain via c.Error, so it
+	// surfaces to downstream error handlers / recovery middleware instead of
+	// being silently dropped. Use WrapLogValuesFunc to adapt a callback written
+	// against the old signature (without a return value).
+	LogValuesFunc func(c *gin.Context, v RequestLoggerParams) error
+
+	// LogRequestBody instructs logger to capture the request body, subject to
+	// MaxBodyLogSize and BodyLogContentTypes.
+	LogRequestBody bool
+	// LogResponseBody instructs logger to capture the response body, subject to
+	// MaxBodyLogSize and BodyLogContentTypes.
+	LogResponseBody bool
+	// MaxBodyLogSize caps the number of bytes buffered per body. Bodies larger
+	// than this are captured up to the cap and flagged as truncated.
+	MaxBodyLogSize int64
+	// BodyLogContentTypes restricts body capture to requests/responses whose
+	// Content-Type matches one of these values (prefix match, e.g. "application/json").
+	// When empty, all content types are captured.
+	BodyLogContentTypes []string
+	// RedactBodyFunc, when set, is applied to a captured body before it is
+	// stored on RequestLoggerParams.
+	RedactBodyFunc RedactBodyFunc
 
 	// LogLatency instructs logger to record how much time the server cost to process a certain request.
 	LogLatency bool
@@ -47,8 +81,27 @@ type LoggerConfig struct {
 	LogResponseSize bool
 	// LogHeaders instructs logger to extract given list of headers from request.
 	LogHeaders []string
+	// LogResponseHeaders instructs logger to extract given list of headers from the response.
+	LogResponseHeaders []string
 	// LogQueryParams instructs logger to extract given list of query parameters from request.
 	LogQueryParams []string
+
+	// NormalizeHeaderKeys instructs RequestLoggerParams.FlatHeaders to lowercase
+	// and snake_case header names (e.g. `X-Request-ID` -> `x_request_id`) so they
+	// read cleanly as flat fields on structured log backends.
+	NormalizeHeaderKeys bool
+
+	// LogTraceID instructs logger to extract the distributed-tracing trace ID.
+	LogTraceID bool
+	// LogSpanID instructs logger to extract the distributed-tracing span ID.
+	LogSpanID bool
+	// LogTraceFlags instructs logger to extract whether the trace is sampled.
+	LogTraceFlags bool
+	// TraceExtractor pulls the trace ID, span ID and sampled flag out of the
+	// request. Defaults to DefaultTraceExtractor, which parses the W3C
+	// traceparent header; pass auditlogger/otelextractor.New() to also read
+	// the span from the request context when the OTel SDK is linked.
+	TraceExtractor TraceExtractor
 }
 
 type RequestLoggerParams struct {
@@ -69,6 +122,118 @@ type RequestLoggerParams struct {
 	ResponseSize  int
 	Headers       map[string][]string
 	QueryParams   map[string][]string
+
+	// ResponseHeaders holds headers listed in LoggerConfig.LogResponseHeaders,
+	// extracted from c.Writer.Header() after c.Next().
+	ResponseHeaders map[string][]string
+
+	// normalizeHeaderKeys mirrors LoggerConfig.NormalizeHeaderKeys and controls
+	// how FlatHeaders renders keys.
+	normalizeHeaderKeys bool
+
+	// RequestBody holds the captured request body when LogRequestBody is set.
+	RequestBody []byte
+	// RequestBodyTruncated reports whether RequestBody was cut off at MaxBodyLogSize.
+	RequestBodyTruncated bool
+	// ResponseBody holds the captured response body when LogResponseBody is set.
+	ResponseBody []byte
+	// ResponseBodyTruncated reports whether ResponseBody was cut off at MaxBodyLogSize.
+	ResponseBodyTruncated bool
+
+	// TraceID is the distributed-tracing trace ID, populated when LogTraceID is set.
+	TraceID string
+	// SpanID is the distributed-tracing span ID, populated when LogSpanID is set.
+	SpanID string
+	// Sampled reports whether the trace is sampled, populated when LogTraceFlags is set.
+	Sampled bool
+}
+
+// FlatHeaders flattens Headers and ResponseHeaders into a single map keyed by
+// header name prefixed with `req_` / `resp_`, joining multi-value headers
+// with ", ". When NormalizeHeaderKeys was set on the LoggerConfig, keys are
+// lowercased and snake_cased (e.g. `X-Request-ID` -> `req_x_request_id`).
+func (v RequestLoggerParams) FlatHeaders() map[string]string {
+	flat := make(map[string]string, len(v.Headers)+len(v.ResponseHeaders))
+	addFlatHeaders(flat, "req_", v.Headers, v.normalizeHeaderKeys)
+	addFlatHeaders(flat, "resp_", v.ResponseHeaders, v.normalizeHeaderKeys)
+	return flat
+}
+
+func addFlatHeaders(flat map[string]string, prefix string, headers map[string][]string, normalize bool) {
+	for key, values := range headers {
+		if normalize {
+			key = strings.ToLower(strings.ReplaceAll(key, "-", "_"))
+		}
+		flat[prefix+key] = strings.Join(values, ", ")
+	}
+}
+
+// bodyLogWriter wraps gin.ResponseWriter to mirror writes into a bounded
+// buffer while still writing through to the real response.
+type bodyLogWriter struct {
+	gin.ResponseWriter
+	buf       *bytes.Buffer
+	maxSize   int64
+	truncated bool
+}
+
+func (w *bodyLogWriter) Write(b []byte) (int, error) {
+	if remaining := w.maxSize - int64(w.buf.Len()); remaining > 0 {
+		if int64(len(b)) > remaining {
+			w.buf.Write(b[:remaining])
+			w.truncated = true
+		} else {
+			w.buf.Write(b)
+		}
+	} else if len(b) > 0 {
+		w.truncated = true
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyLogWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// bodyContentTypeAllowed reports whether contentType matches one of allowed,
+// or allows everything when allowed is empty.
+func bodyContentTypeAllowed(contentType string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		if strings.HasPrefix(contentType, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// captureRequestBody reads up to maxSize bytes of c.Request.Body for
+// logging, then restores an io.ReadCloser that replays the captured bytes
+// (including the one byte read past maxSize to detect truncation) followed
+// by the untouched remainder of the original body, so downstream handlers
+// still see the exact same body without the logger having forced the rest of
+// it into memory up front.
+func captureRequestBody(c *gin.Context, maxSize int64) (body []byte, truncated bool) {
+	if c.Request.Body == nil || maxSize <= 0 {
+		return nil, false
+	}
+
+	read, err := io.ReadAll(io.LimitReader(c.Request.Body, maxSize+1))
+	if err != nil {
+		read = nil
+	}
+
+	captured, extra := read, []byte(nil)
+	if int64(len(read)) > maxSize {
+		truncated = true
+		captured, extra = read[:maxSize], read[maxSize:]
+	}
+
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(captured), bytes.NewReader(extra), c.Request.Body))
+
+	return captured, truncated
 }
 
 func LoggerWithConfig(cfg LoggerConfig) gin.HandlerFunc {
@@ -84,15 +249,72 @@ func LoggerWithConfig(cfg LoggerConfig) gin.HandlerFunc {
 		// Start timer
 		startTime := time.Now()
 
+		// Path-based skips are also checked up front, so requests whose path
+		// already matches don't pay for request/response body buffering below.
+		// The skip decision itself is made after c.Next().
+		path := c.Request.URL.Path
+		pathSkipped := skipPaths[path] || matchesAnyRegexp(path, cfg.SkipPathRegexps)
+
+		var reqBody []byte
+		var reqBodyTruncated bool
+		if !pathSkipped && cfg.LogRequestBody && bodyContentTypeAllowed(c.Request.Header.Get("Content-Type"), cfg.BodyLogContentTypes) {
+			reqBody, reqBodyTruncated = captureRequestBody(c, cfg.MaxBodyLogSize)
+		}
+
+		var blw *bodyLogWriter
+		if !pathSkipped && cfg.LogResponseBody {
+			blw = &bodyLogWriter{ResponseWriter: c.Writer, buf: &bytes.Buffer{}, maxSize: cfg.MaxBodyLogSize}
+			c.Writer = blw
+		}
+
 		// Process request
 		c.Next()
 
-		path := c.Request.URL.Path
-		if ok := skipPaths[path]; ok || (cfg.Skipper != nil && cfg.Skipper(c)) {
+		path = c.Request.URL.Path
+		if skipPaths[path] || matchesAnyRegexp(path, cfg.SkipPathRegexps) || (cfg.Skipper != nil && cfg.Skipper(c)) {
 			return
 		}
 
-		params := RequestLoggerParams{StartTime: startTime}
+		params := RequestLoggerParams{StartTime: startTime, normalizeHeaderKeys: cfg.NormalizeHeaderKeys}
+
+		if length := len(cfg.LogHeaders); length > 0 {
+			headers := c.Request.Header
+			params.Headers = make(map[string][]string, length)
+			for _, name := range cfg.LogHeaders {
+				if values := headers.Values(name); len(values) > 0 {
+					params.Headers[name] = values
+				}
+			}
+		}
+
+		if length := len(cfg.LogResponseHeaders); length > 0 {
+			headers := c.Writer.Header()
+			params.ResponseHeaders = make(map[string][]string, length)
+			for _, name := range cfg.LogResponseHeaders {
+				if values := headers.Values(name); len(values) > 0 {
+					params.ResponseHeaders[name] = values
+				}
+			}
+		}
+
+		if cfg.LogRequestBody {
+			params.RequestBody = reqBody
+			params.RequestBodyTruncated = reqBodyTruncated
+			if cfg.RedactBodyFunc != nil {
+				params.RequestBody = cfg.RedactBodyFunc(c.Request.Header.Get("Content-Type"), params.RequestBody)
+			}
+		}
+
+		if cfg.LogResponseBody && blw != nil {
+			if bodyContentTypeAllowed(blw.Header().Get("Content-Type"), cfg.BodyLogContentTypes) {
+				params.ResponseBody = blw.buf.Bytes()
+				params.ResponseBodyTruncated = blw.truncated
+				if cfg.RedactBodyFunc != nil {
+					params.ResponseBody = cfg.RedactBodyFunc(blw.Header().Get("Content-Type"), params.ResponseBody)
+				}
+			}
+		}
+
 		if cfg.LogProtocol {
 			params.Protocol = c.Request.Proto
 		}
@@ -141,8 +363,37 @@ func LoggerWithConfig(cfg LoggerConfig) gin.HandlerFunc {
 			params.Latency = time.Since(startTime)
 		}
 
+		if cfg.LogTraceID || cfg.LogSpanID || cfg.LogTraceFlags {
+			extractor := cfg.TraceExtractor
+			if extractor == nil {
+				extractor = DefaultTraceExtractor
+			}
+
+			traceID, spanID, sampled := extractor(c)
+			if cfg.LogTraceID {
+				params.TraceID = traceID
+			}
+			if cfg.LogSpanID {
+				params.SpanID = spanID
+			}
+			if cfg.LogTraceFlags {
+				params.Sampled = sampled
+			}
+		}
+
 		if cfg.LogValuesFunc != nil {
-			cfg.LogValuesFunc(c, params)
+			if err := cfg.LogValuesFunc(c, params); err != nil {
+				c.Error(err)
+			}
 		}
 	}
 }
+
+// WrapLogValuesFunc adapts a LogValuesFunc written against the pre-error-return
+// signature so it can still be assigned to LoggerConfig.LogValuesFunc.
+func WrapLogValuesFunc(fn func(c *gin.Context, v RequestLoggerParams)) func(c *gin.Context, v RequestLoggerParams) error {
+	return func(c *gin.Context, v RequestLoggerParams) error {
+		fn(c, v)
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+package auditlogger
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCaptureRequestBody(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		maxSize       int64
+		wantCaptured  string
+		wantTruncated bool
+	}{
+		{name: "under cap", body: "HELLO WORLD", maxSize: 1024, wantCaptured: "HELLO WORLD", wantTruncated: false},
+		{name: "exactly at cap", body: "HELLO", maxSize: 5, wantCaptured: "HELLO", wantTruncated: false},
+		{name: "over cap", body: "HELLO WORLD", maxSize: 5, wantCaptured: "HELLO", wantTruncated: true},
+		{name: "empty body", body: "", maxSize: 1024, wantCaptured: "", wantTruncated: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+
+			gin.SetMode(gin.TestMode)
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = req
+
+			captured, truncated := captureRequestBody(c, tt.maxSize)
+			if string(captured) != tt.wantCaptured {
+				t.Errorf("captured = %q, want %q", captured, tt.wantCaptured)
+			}
+			if truncated != tt.wantTruncated {
+				t.Errorf("truncated = %v, want %v", truncated, tt.wantTruncated)
+			}
+
+			rest, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				t.Fatalf("reading restored body: %v", err)
+			}
+			if string(rest) != tt.body {
+				t.Errorf("downstream body = %q, want %q (original untouched)", rest, tt.body)
+			}
+		})
+	}
+}
+
+func TestLoggerWithConfigPreservesRequestBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		body    string
+		maxSize int64
+	}{
+		{name: "not truncated", body: "HELLO WORLD", maxSize: 1024},
+		{name: "truncated", body: "HELLO WORLD", maxSize: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBody string
+			var gotParams RequestLoggerParams
+
+			router := gin.New()
+			router.Use(LoggerWithConfig(LoggerConfig{
+				LogRequestBody: true,
+				MaxBodyLogSize: tt.maxSize,
+				LogValuesFunc: func(c *gin.Context, v RequestLoggerParams) error {
+					gotParams = v
+					return nil
+				},
+			}))
+			router.POST("/echo", func(c *gin.Context) {
+				b, err := io.ReadAll(c.Request.Body)
+				if err != nil {
+					c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+				gotBody = string(b)
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/echo", bytes.NewBufferString(tt.body))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if gotBody != tt.body {
+				t.Errorf("handler saw body %q, want %q", gotBody, tt.body)
+			}
+			if len(tt.body) > int(tt.maxSize) && !gotParams.RequestBodyTruncated {
+				t.Errorf("expected RequestBodyTruncated = true")
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Package otelextractor provides an auditlogger.TraceExtractor backed by the
+// OpenTelemetry SDK. It is a separate package, rather than a build tag on
+// auditlogger, so that pulling in go.opentelemetry.io/otel stays opt-in for
+// callers who don't otherwise link it.
+package otelextractor
+
+import (
+	"github.com/gin-gonic/gin"
+	auditlogger "github.com/tniah/gin-middleware/audit_logger"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// New returns a TraceExtractor that reads the active span from the request
+// context (as set up by otelgin or similar instrumentation) and falls back to
+// parsing the W3C traceparent header when the context carries no span.
+func New() auditlogger.TraceExtractor {
+	return func(c *gin.Context) (traceID, spanID string, sampled bool) {
+		span := trace.SpanContextFromContext(c.Request.Context())
+		if span.IsValid() {
+			return span.TraceID().String(), span.SpanID().String(), span.IsSampled()
+		}
+		return auditlogger.DefaultTraceExtractor(c)
+	}
+}
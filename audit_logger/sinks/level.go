@@ -0,0 +1,35 @@
+// Package sinks holds the shared Level/LevelFunc types used by the
+// per-library adapter packages (slogsink, zerologsink, zapsink, logrussink),
+// each of which provides a ready-made LogValuesFunc for one of the dominant
+// Go structured logging libraries. The adapters live in their own packages,
+// rather than alongside each other here, so picking one doesn't drag the
+// other libraries in as compile-time dependencies.
+package sinks
+
+import auditlogger "github.com/tniah/gin-middleware/audit_logger"
+
+// Level is a normalized log severity used to pick the right method on the
+// underlying logger, independent of which library backs a given sink.
+type Level int
+
+const (
+	LevelInfo Level = iota
+	LevelWarn
+	LevelError
+)
+
+// LevelFunc decides which Level a request should be logged at.
+type LevelFunc func(v auditlogger.RequestLoggerParams) Level
+
+// DefaultLevelFunc logs 5xx responses at error, 4xx at warn, and everything
+// else (2xx/3xx) at info.
+func DefaultLevelFunc(v auditlogger.RequestLoggerParams) Level {
+	switch {
+	case v.Status >= 500:
+		return LevelError
+	case v.Status >= 400:
+		return LevelWarn
+	default:
+		return LevelInfo
+	}
+}
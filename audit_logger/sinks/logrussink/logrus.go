@@ -0,0 +1,50 @@
+// Package logrussink adapts auditlogger.RequestLoggerParams to logrus. It is
+// split out from the other sinks packages so that using it doesn't drag in
+// slog, zerolog, or zap as compile-time dependencies.
+package logrussink
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	auditlogger "github.com/tniah/gin-middleware/audit_logger"
+	"github.com/tniah/gin-middleware/audit_logger/sinks"
+)
+
+// NewLogrusLogValuesFunc returns a LogValuesFunc that writes RequestLoggerParams
+// to logger using logrus's structured field API. A nil levelFunc falls back
+// to sinks.DefaultLevelFunc.
+func NewLogrusLogValuesFunc(logger *logrus.Logger, levelFunc sinks.LevelFunc) func(c *gin.Context, v auditlogger.RequestLoggerParams) error {
+	if levelFunc == nil {
+		levelFunc = sinks.DefaultLevelFunc
+	}
+
+	return func(c *gin.Context, v auditlogger.RequestLoggerParams) error {
+		fields := logrus.Fields{
+			"status":    v.Status,
+			"latency":   v.Latency,
+			"method":    v.Method,
+			"path":      v.URIPath,
+			"remote_ip": v.RemoteIP,
+		}
+		if len(v.Headers) > 0 {
+			fields["headers"] = v.Headers
+		}
+		if v.Error != "" {
+			fields["error"] = v.Error
+		}
+
+		logger.WithFields(fields).Log(logrusLevel(levelFunc(v)), "request")
+		return nil
+	}
+}
+
+func logrusLevel(l sinks.Level) logrus.Level {
+	switch l {
+	case sinks.LevelError:
+		return logrus.ErrorLevel
+	case sinks.LevelWarn:
+		return logrus.WarnLevel
+	default:
+		return logrus.InfoLevel
+	}
+}
@@ -0,0 +1,51 @@
+// Package slogsink adapts auditlogger.RequestLoggerParams to log/slog. It is
+// split out from the other sinks packages so that using it doesn't drag in
+// zerolog, zap, or logrus as compile-time dependencies.
+package slogsink
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	auditlogger "github.com/tniah/gin-middleware/audit_logger"
+	"github.com/tniah/gin-middleware/audit_logger/sinks"
+)
+
+// NewSlogLogValuesFunc returns a LogValuesFunc that writes RequestLoggerParams
+// to logger using log/slog's structured attribute API. A nil levelFunc falls
+// back to sinks.DefaultLevelFunc.
+func NewSlogLogValuesFunc(logger *slog.Logger, levelFunc sinks.LevelFunc) func(c *gin.Context, v auditlogger.RequestLoggerParams) error {
+	if levelFunc == nil {
+		levelFunc = sinks.DefaultLevelFunc
+	}
+
+	return func(c *gin.Context, v auditlogger.RequestLoggerParams) error {
+		attrs := []slog.Attr{
+			slog.Int("status", v.Status),
+			slog.Duration("latency", v.Latency),
+			slog.String("method", v.Method),
+			slog.String("path", v.URIPath),
+			slog.String("remote_ip", v.RemoteIP),
+		}
+		if len(v.Headers) > 0 {
+			attrs = append(attrs, slog.Any("headers", v.Headers))
+		}
+		if v.Error != "" {
+			attrs = append(attrs, slog.String("error", v.Error))
+		}
+
+		logger.LogAttrs(c.Request.Context(), slogLevel(levelFunc(v)), "request", attrs...)
+		return nil
+	}
+}
+
+func slogLevel(l sinks.Level) slog.Level {
+	switch l {
+	case sinks.LevelError:
+		return slog.LevelError
+	case sinks.LevelWarn:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,51 @@
+// Package zapsink adapts auditlogger.RequestLoggerParams to zap. It is split
+// out from the other sinks packages so that using it doesn't drag in slog,
+// zerolog, or logrus as compile-time dependencies.
+package zapsink
+
+import (
+	"github.com/gin-gonic/gin"
+	auditlogger "github.com/tniah/gin-middleware/audit_logger"
+	"github.com/tniah/gin-middleware/audit_logger/sinks"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// NewZapLogValuesFunc returns a LogValuesFunc that writes RequestLoggerParams
+// to logger using zap's structured field API. A nil levelFunc falls back to
+// sinks.DefaultLevelFunc.
+func NewZapLogValuesFunc(logger *zap.Logger, levelFunc sinks.LevelFunc) func(c *gin.Context, v auditlogger.RequestLoggerParams) error {
+	if levelFunc == nil {
+		levelFunc = sinks.DefaultLevelFunc
+	}
+
+	return func(c *gin.Context, v auditlogger.RequestLoggerParams) error {
+		fields := []zap.Field{
+			zap.Int("status", v.Status),
+			zap.Duration("latency", v.Latency),
+			zap.String("method", v.Method),
+			zap.String("path", v.URIPath),
+			zap.String("remote_ip", v.RemoteIP),
+		}
+		if len(v.Headers) > 0 {
+			fields = append(fields, zap.Any("headers", v.Headers))
+		}
+		if v.Error != "" {
+			fields = append(fields, zap.String("error", v.Error))
+		}
+
+		logger.Check(zapLevel(levelFunc(v)), "request").Write(fields...)
+		return nil
+	}
+}
+
+func zapLevel(l sinks.Level) zapcore.Level {
+	switch l {
+	case sinks.LevelError:
+		return zapcore.ErrorLevel
+	case sinks.LevelWarn:
+		return zapcore.WarnLevel
+	default:
+		return zapcore.InfoLevel
+	}
+}
@@ -0,0 +1,51 @@
+// Package zerologsink adapts auditlogger.RequestLoggerParams to zerolog. It
+// is split out from the other sinks packages so that using it doesn't drag
+// in slog, zap, or logrus as compile-time dependencies.
+package zerologsink
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	auditlogger "github.com/tniah/gin-middleware/audit_logger"
+	"github.com/tniah/gin-middleware/audit_logger/sinks"
+)
+
+// NewZerologLogValuesFunc returns a LogValuesFunc that writes RequestLoggerParams
+// to logger using zerolog's structured event API. A nil levelFunc falls back
+// to sinks.DefaultLevelFunc.
+func NewZerologLogValuesFunc(logger zerolog.Logger, levelFunc sinks.LevelFunc) func(c *gin.Context, v auditlogger.RequestLoggerParams) error {
+	if levelFunc == nil {
+		levelFunc = sinks.DefaultLevelFunc
+	}
+
+	return func(c *gin.Context, v auditlogger.RequestLoggerParams) error {
+		event := logger.WithLevel(zerologLevel(levelFunc(v)))
+		event = event.
+			Int("status", v.Status).
+			Dur("latency", v.Latency).
+			Str("method", v.Method).
+			Str("path", v.URIPath).
+			Str("remote_ip", v.RemoteIP)
+
+		if len(v.Headers) > 0 {
+			event = event.Interface("headers", v.Headers)
+		}
+		if v.Error != "" {
+			event = event.Str("error", v.Error)
+		}
+
+		event.Msg("request")
+		return nil
+	}
+}
+
+func zerologLevel(l sinks.Level) zerolog.Level {
+	switch l {
+	case sinks.LevelError:
+		return zerolog.ErrorLevel
+	case sinks.LevelWarn:
+		return zerolog.WarnLevel
+	default:
+		return zerolog.InfoLevel
+	}
+}
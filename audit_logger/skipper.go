@@ -0,0 +1,53 @@
+package auditlogger
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+)
+
+// matchesAnyRegexp reports whether path matches any of the given regexps.
+func matchesAnyRegexp(path string, regexps []*regexp.Regexp) bool {
+	for _, re := range regexps {
+		if re != nil && re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipPathPrefix returns a Skipper that skips requests whose URL path starts
+// with any of the given prefixes.
+func SkipPathPrefix(prefixes ...string) Skipper {
+	return func(c *gin.Context) bool {
+		path := c.Request.URL.Path
+		for _, prefix := range prefixes {
+			if len(path) >= len(prefix) && path[:len(prefix)] == prefix {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// SkipStatusBelow returns a Skipper that skips requests whose response
+// status code is lower than code. It must be used after c.Next() has run,
+// i.e. as the Skipper passed to LoggerWithConfig.
+func SkipStatusBelow(code int) Skipper {
+	return func(c *gin.Context) bool {
+		return c.Writer.Status() < code
+	}
+}
+
+// CombineSkippers returns a Skipper that skips a request when any of the
+// given skippers would skip it.
+func CombineSkippers(skippers ...Skipper) Skipper {
+	return func(c *gin.Context) bool {
+		for _, skip := range skippers {
+			if skip != nil && skip(c) {
+				return true
+			}
+		}
+		return false
+	}
+}
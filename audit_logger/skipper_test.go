@@ -0,0 +1,92 @@
+package auditlogger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSkipPathPrefix(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	skip := SkipPathPrefix("/health", "/metrics")
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/health", true},
+		{"/health/live", true},
+		{"/metrics", true},
+		{"/api/v1/users", false},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = req
+
+		if got := skip(c); got != tt.want {
+			t.Errorf("SkipPathPrefix(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSkipStatusBelow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	skip := SkipStatusBelow(400)
+
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Status(http.StatusOK)
+
+	if !skip(c) {
+		t.Errorf("SkipStatusBelow(400) should skip a 200 response")
+	}
+
+	c.Status(http.StatusInternalServerError)
+	if skip(c) {
+		t.Errorf("SkipStatusBelow(400) should not skip a 500 response")
+	}
+}
+
+func TestCombineSkippers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	never := func(c *gin.Context) bool { return false }
+	always := func(c *gin.Context) bool { return true }
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	if CombineSkippers(never, never)(c) {
+		t.Errorf("expected no skipper to match")
+	}
+	if !CombineSkippers(never, always)(c) {
+		t.Errorf("expected combined skipper to match when any skipper matches")
+	}
+}
+
+func TestLoggerWithConfigSkipPathRegexps(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var called bool
+	router := gin.New()
+	router.Use(LoggerWithConfig(LoggerConfig{
+		SkipPathRegexps: []*regexp.Regexp{regexp.MustCompile(`^/health`)},
+		LogValuesFunc: func(c *gin.Context, v RequestLoggerParams) error {
+			called = true
+			return nil
+		},
+	}))
+	router.GET("/health/live", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/health/live", nil)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if called {
+		t.Errorf("LogValuesFunc should not be called for a path matching SkipPathRegexps")
+	}
+}
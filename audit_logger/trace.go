@@ -0,0 +1,57 @@
+package auditlogger
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TraceExtractor pulls distributed-tracing identifiers out of the request so
+// they can be correlated with audit logs. DefaultTraceExtractor parses the
+// W3C traceparent header; callers linking an OTel SDK can instead use
+// auditlogger/otelextractor, which also reads the span from the request
+// context.
+type TraceExtractor func(c *gin.Context) (traceID, spanID string, sampled bool)
+
+// traceFlagSampled is the W3C trace-flags bit that marks a trace as sampled.
+const traceFlagSampled = 0x01
+
+// DefaultTraceExtractor parses the W3C `traceparent` header
+// (`00-<32hex trace>-<16hex span>-<2hex flags>`). It returns zero values when
+// the header is absent or malformed.
+func DefaultTraceExtractor(c *gin.Context) (traceID, spanID string, sampled bool) {
+	return parseTraceParent(c.GetHeader("traceparent"))
+}
+
+// parseTraceParent parses a W3C traceparent header value.
+func parseTraceParent(header string) (traceID, spanID string, sampled bool) {
+	parts := strings.Split(header, "-")
+	if len(parts) != 4 {
+		return "", "", false
+	}
+
+	version, tid, sid, flags := parts[0], parts[1], parts[2], parts[3]
+	if len(version) != 2 || len(tid) != 32 || len(sid) != 16 || len(flags) != 2 {
+		return "", "", false
+	}
+	if !isHex(tid) || !isHex(sid) || !isHex(flags) {
+		return "", "", false
+	}
+
+	flagByte, err := strconv.ParseUint(flags, 16, 8)
+	if err != nil {
+		return "", "", false
+	}
+
+	return tid, sid, flagByte&traceFlagSampled != 0
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !((r >= '0' && r <= '9') || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')) {
+			return false
+		}
+	}
+	return true
+}
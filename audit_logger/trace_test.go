@@ -0,0 +1,44 @@
+package auditlogger
+
+import "testing"
+
+func TestParseTraceParent(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      string
+		wantTraceID string
+		wantSpanID  string
+		wantSampled bool
+	}{
+		{
+			name:        "valid sampled",
+			header:      "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			wantSpanID:  "00f067aa0ba902b7",
+			wantSampled: true,
+		},
+		{
+			name:        "valid not sampled",
+			header:      "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+			wantTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			wantSpanID:  "00f067aa0ba902b7",
+			wantSampled: false,
+		},
+		{name: "empty header"},
+		{name: "wrong number of segments", header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7"},
+		{name: "short trace id", header: "00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01"},
+		{name: "short span id", header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b-01"},
+		{name: "non-hex trace id", header: "00-zzf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		{name: "non-hex flags", header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-zz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			traceID, spanID, sampled := parseTraceParent(tt.header)
+			if traceID != tt.wantTraceID || spanID != tt.wantSpanID || sampled != tt.wantSampled {
+				t.Errorf("parseTraceParent(%q) = (%q, %q, %v), want (%q, %q, %v)",
+					tt.header, traceID, spanID, sampled, tt.wantTraceID, tt.wantSpanID, tt.wantSampled)
+			}
+		})
+	}
+}